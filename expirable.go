@@ -0,0 +1,246 @@
+package lrulist
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+type expirableLRUListNode[K comparable, V any] struct {
+	key        K
+	val        V
+	expiresAt  time.Time
+	prev, next *expirableLRUListNode[K, V]
+}
+
+// ExpirableLRUList is an LRUList variant whose entries additionally carry a
+// per-item TTL. Entries past their TTL are treated as misses on Get and are
+// swept up periodically by a background goroutine, independent of LRU
+// pressure. The sweeper runs only when the list's default ttl is positive;
+// otherwise an expired entry stays until Get reaches it or LRU pressure
+// evicts it.
+type ExpirableLRUList[K comparable, V any] struct {
+	// Capacity limit of the LRU list
+	cap int
+	// Default TTL applied by Set; also used as the sweep interval
+	ttl time.Duration
+	// Mapping from cache keys to LRU nodes
+	cacheMap map[K]*expirableLRUListNode[K, V]
+	// Head node of the LRU list
+	cache *expirableLRUListNode[K, V]
+	// Callback function for eviction when exceeding capacity or expiring
+	evict func(K, V) error
+	// Read-write lock to protect concurrent access to the LRU list
+	lock sync.RWMutex
+	// Closes the background sweeper goroutine
+	done chan struct{}
+}
+
+func NewExpirableLRUList[K comparable, V any](cap int, ttl time.Duration, evict func(K, V) error) *ExpirableLRUList[K, V] {
+	lruc := &ExpirableLRUList[K, V]{
+		cap:      cap,
+		ttl:      ttl,
+		cacheMap: make(map[K]*expirableLRUListNode[K, V]),
+		cache:    nil,
+		evict:    evict,
+		done:     make(chan struct{}),
+	}
+
+	if ttl > 0 {
+		go lruc.sweep(ttl)
+	}
+
+	return lruc
+}
+
+// Close stops the background sweeper goroutine. It is safe to call once.
+func (lruc *ExpirableLRUList[K, V]) Close() {
+	close(lruc.done)
+}
+
+func (lruc *ExpirableLRUList[K, V]) sweep(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-lruc.done:
+			return
+		case <-ticker.C:
+			lruc.purgeExpired()
+		}
+	}
+}
+
+func (lruc *ExpirableLRUList[K, V]) purgeExpired() {
+	lruc.lock.Lock()
+	defer lruc.lock.Unlock()
+
+	if lruc.cache == nil {
+		return
+	}
+
+	now := time.Now()
+
+	// Collect expired nodes first so removal doesn't disturb the walk.
+	var expired []*expirableLRUListNode[K, V]
+	cur := lruc.cache
+	for {
+		if !cur.expiresAt.IsZero() && now.After(cur.expiresAt) {
+			expired = append(expired, cur)
+		}
+		cur = cur.next
+		if cur == lruc.cache {
+			break
+		}
+	}
+
+	for _, item := range expired {
+		lruc.removeNode(item)
+		if lruc.evict != nil {
+			lruc.evict(item.key, item.val)
+		}
+	}
+}
+
+// removeNode unlinks a node from the list and its entry from the map. The
+// caller must hold lruc.lock.
+func (lruc *ExpirableLRUList[K, V]) removeNode(item *expirableLRUListNode[K, V]) {
+	delete(lruc.cacheMap, item.key)
+
+	if item.next == item {
+		// item was the only node in the list
+		lruc.cache = nil
+		return
+	}
+
+	item.prev.next, item.next.prev = item.next, item.prev
+	if lruc.cache == item {
+		lruc.cache = item.next
+	}
+}
+
+// Set inserts or updates key with the list's default TTL.
+func (lruc *ExpirableLRUList[K, V]) Set(key K, val V) error {
+	return lruc.SetWithTTL(key, val, lruc.ttl)
+}
+
+// SetWithTTL inserts or updates key with a TTL independent of the list's
+// default. A zero ttl means the entry never expires on its own.
+func (lruc *ExpirableLRUList[K, V]) SetWithTTL(key K, val V, ttl time.Duration) error {
+	lruc.lock.Lock()
+	defer lruc.lock.Unlock()
+
+	var expiresAt time.Time
+	if ttl > 0 {
+		expiresAt = time.Now().Add(ttl)
+	}
+
+	if item, hit := lruc.cacheMap[key]; hit {
+		// If the key already exists in the cache
+		if item != lruc.cache {
+			// Move the node to the head of the LRU list
+			item.prev.next, item.next.prev = item.next, item.prev
+
+			item.prev, item.next = lruc.cache.prev, lruc.cache
+			lruc.cache.prev.next, lruc.cache.prev = item, item
+			lruc.cache = item
+		}
+		// Update the value and expiry of the head node
+		lruc.cache.val = val
+		lruc.cache.expiresAt = expiresAt
+	} else {
+		if len(lruc.cacheMap) < lruc.cap {
+			// If the cache is not full, create new node
+			item := &expirableLRUListNode[K, V]{key: key, val: val, expiresAt: expiresAt}
+			lruc.cacheMap[key] = item
+
+			if lruc.cache == nil {
+				// The LRU list is empty, set the node as the head node
+				item.prev, item.next = item, item
+			} else {
+				// Insert the node at the head of the LRU list
+				item.prev, item.next = lruc.cache.prev, lruc.cache
+				lruc.cache.prev.next, lruc.cache.prev = item, item
+			}
+			// Update the head node of the LRU list
+			lruc.cache = item
+		} else {
+			// If the cache is full, replace the least recently used node
+			lruc.cache = lruc.cache.prev
+
+			if lruc.evict != nil {
+				// Execute the callback function to handle the replaced node
+				if err := lruc.evict(lruc.cache.key, lruc.cache.val); err != nil {
+					return err
+				}
+			}
+
+			// Remove the replaced node from the cache mapping
+			delete(lruc.cacheMap, lruc.cache.key)
+			// Update the cache mapping
+			lruc.cacheMap[key] = lruc.cache
+			// Update the key, value and expiry of the head node
+			lruc.cache.key, lruc.cache.val, lruc.cache.expiresAt = key, val, expiresAt
+		}
+	}
+
+	return nil
+}
+
+// Get returns the value for key, treating expired entries as misses and
+// evicting them immediately via the eviction callback.
+func (lruc *ExpirableLRUList[K, V]) Get(key K) (V, error) {
+	lruc.lock.Lock()
+	defer lruc.lock.Unlock()
+
+	item, hit := lruc.cacheMap[key]
+	if !hit {
+		var zero V
+		return zero, fmt.Errorf("key doesn't hit")
+	}
+
+	if !item.expiresAt.IsZero() && time.Now().After(item.expiresAt) {
+		lruc.removeNode(item)
+		if lruc.evict != nil {
+			lruc.evict(item.key, item.val)
+		}
+		var zero V
+		return zero, fmt.Errorf("key doesn't hit")
+	}
+
+	// If the key exists in the cache
+	if item != lruc.cache {
+		// Move the node to the head of the LRU list
+		item.prev.next, item.next.prev = item.next, item.prev
+
+		item.prev, item.next = lruc.cache.prev, lruc.cache
+		lruc.cache.prev.next, lruc.cache.prev = item, item
+		lruc.cache = item
+	}
+	return lruc.cache.val, nil
+}
+
+func (lruc *ExpirableLRUList[K, V]) Traverse(visit func(K, V) error) error {
+	lruc.lock.RLock()
+	defer lruc.lock.RUnlock()
+
+	if lruc.cache == nil {
+		return nil
+	}
+
+	// Visit the head node of the LRU list
+	err := visit(lruc.cache.key, lruc.cache.val)
+	if err != nil {
+		return err
+	}
+	for cur := lruc.cache.next; cur != lruc.cache; cur = cur.next {
+		// Visit the other nodes of the LRU list
+		err := visit(cur.key, cur.val)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
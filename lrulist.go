@@ -5,35 +5,36 @@ import (
 	"sync"
 )
 
-type LRUListNode struct {
-	key, val   interface{}
-	prev, next *LRUListNode
+type LRUListNode[K comparable, V any] struct {
+	key        K
+	val        V
+	prev, next *LRUListNode[K, V]
 }
 
-type LRUList struct {
+type LRUList[K comparable, V any] struct {
 	// Capacity limit of the LRU list
 	cap int
 	// Mapping from cache keys to LRU nodes
-	cacheMap map[interface{}]*LRUListNode
+	cacheMap map[K]*LRUListNode[K, V]
 	// Head node of the LRU list
-	cache *LRUListNode
+	cache *LRUListNode[K, V]
 	// Callback function for eviction when exceeding capacity
-	evict func(interface{}) error
+	evict func(K, V) error
 	// Read-write lock to protect concurrent access to the LRU list
 	lock sync.RWMutex
 }
 
-func NewLRUList(cap int, evict func(interface{}) error) *LRUList {
-	return &LRUList{
+func New[K comparable, V any](cap int, evict func(K, V) error) *LRUList[K, V] {
+	return &LRUList[K, V]{
 		cap:      cap,
-		cacheMap: make(map[interface{}]*LRUListNode),
+		cacheMap: make(map[K]*LRUListNode[K, V]),
 		cache:    nil,
 		evict:    evict,
 		lock:     sync.RWMutex{},
 	}
 }
 
-func (lruc *LRUList) Set(key, val interface{}) error {
+func (lruc *LRUList[K, V]) Set(key K, val V) error {
 	lruc.lock.Lock()
 	defer lruc.lock.Unlock()
 
@@ -52,7 +53,7 @@ func (lruc *LRUList) Set(key, val interface{}) error {
 	} else {
 		if len(lruc.cacheMap) < lruc.cap {
 			// If the cache is not full, create new node
-			item := &LRUListNode{key: key, val: val}
+			item := &LRUListNode[K, V]{key: key, val: val}
 			lruc.cacheMap[key] = item
 
 			if lruc.cache == nil {
@@ -71,7 +72,7 @@ func (lruc *LRUList) Set(key, val interface{}) error {
 
 			if lruc.evict != nil {
 				// Execute the callback function to handle the replaced node
-				if err := lruc.evict(lruc.cache.val); err != nil {
+				if err := lruc.evict(lruc.cache.key, lruc.cache.val); err != nil {
 					return err
 				}
 			}
@@ -88,7 +89,7 @@ func (lruc *LRUList) Set(key, val interface{}) error {
 	return nil
 }
 
-func (lruc *LRUList) Get(key interface{}) (interface{}, error) {
+func (lruc *LRUList[K, V]) Get(key K) (V, error) {
 	lruc.lock.Lock()
 	defer lruc.lock.Unlock()
 
@@ -104,11 +105,12 @@ func (lruc *LRUList) Get(key interface{}) (interface{}, error) {
 		}
 		return lruc.cache.val, nil
 	} else {
-		return nil, fmt.Errorf("key doesn't hit")
+		var zero V
+		return zero, fmt.Errorf("key doesn't hit")
 	}
 }
 
-func (lruc *LRUList) Traverse(visit func(interface{}) error) error {
+func (lruc *LRUList[K, V]) Traverse(visit func(K, V) error) error {
 	lruc.lock.RLock()
 	defer lruc.lock.RUnlock()
 
@@ -117,13 +119,13 @@ func (lruc *LRUList) Traverse(visit func(interface{}) error) error {
 	}
 
 	// Visit the head node of the LRU list
-	err := visit(lruc.cache.val)
+	err := visit(lruc.cache.key, lruc.cache.val)
 	if err != nil {
 		return err
 	}
 	for cur := lruc.cache.next; cur != lruc.cache; cur = cur.next {
 		// Visit the other nodes of the LRU list
-		err := visit(cur.val)
+		err := visit(cur.key, cur.val)
 		if err != nil {
 			return err
 		}
@@ -131,3 +133,106 @@ func (lruc *LRUList) Traverse(visit func(interface{}) error) error {
 
 	return nil
 }
+
+// Peek returns the value for key without promoting it to the head of the
+// LRU list.
+func (lruc *LRUList[K, V]) Peek(key K) (V, error) {
+	lruc.lock.RLock()
+	defer lruc.lock.RUnlock()
+
+	if item, hit := lruc.cacheMap[key]; hit {
+		return item.val, nil
+	}
+
+	var zero V
+	return zero, fmt.Errorf("key doesn't hit")
+}
+
+// Contains reports whether key is in the cache, without promoting it.
+func (lruc *LRUList[K, V]) Contains(key K) bool {
+	lruc.lock.RLock()
+	defer lruc.lock.RUnlock()
+
+	_, hit := lruc.cacheMap[key]
+	return hit
+}
+
+// Len returns the number of entries currently in the cache.
+func (lruc *LRUList[K, V]) Len() int {
+	lruc.lock.RLock()
+	defer lruc.lock.RUnlock()
+
+	return len(lruc.cacheMap)
+}
+
+// Remove deletes key from the cache, firing the eviction callback if one
+// is set.
+func (lruc *LRUList[K, V]) Remove(key K) error {
+	lruc.lock.Lock()
+	defer lruc.lock.Unlock()
+
+	item, hit := lruc.cacheMap[key]
+	if !hit {
+		return fmt.Errorf("key doesn't hit")
+	}
+
+	lruc.removeNode(item)
+	if lruc.evict != nil {
+		return lruc.evict(item.key, item.val)
+	}
+	return nil
+}
+
+// Purge evicts every entry, oldest (least recently used) first, firing
+// the eviction callback for each.
+func (lruc *LRUList[K, V]) Purge() error {
+	lruc.lock.Lock()
+	defer lruc.lock.Unlock()
+
+	for lruc.cache != nil {
+		victim := lruc.cache.prev
+		lruc.removeNode(victim)
+		if lruc.evict != nil {
+			if err := lruc.evict(victim.key, victim.val); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Resize changes the cache's capacity. Shrinking evicts the oldest
+// entries, in LRU order, until the new capacity is met.
+func (lruc *LRUList[K, V]) Resize(newCap int) error {
+	lruc.lock.Lock()
+	defer lruc.lock.Unlock()
+
+	for len(lruc.cacheMap) > newCap {
+		victim := lruc.cache.prev
+		lruc.removeNode(victim)
+		if lruc.evict != nil {
+			if err := lruc.evict(victim.key, victim.val); err != nil {
+				return err
+			}
+		}
+	}
+
+	lruc.cap = newCap
+	return nil
+}
+
+// removeNode unlinks item from the circular list and deletes it from the
+// cache map. The caller must hold lruc.lock for writing.
+func (lruc *LRUList[K, V]) removeNode(item *LRUListNode[K, V]) {
+	delete(lruc.cacheMap, item.key)
+
+	if item.next == item {
+		lruc.cache = nil
+		return
+	}
+
+	item.prev.next, item.next.prev = item.next, item.prev
+	if lruc.cache == item {
+		lruc.cache = item.next
+	}
+}
@@ -0,0 +1,62 @@
+package lrulist
+
+import (
+	"fmt"
+	"hash/fnv"
+)
+
+// ShardedLRUList fans a cache out across N independent LRUList shards so
+// that concurrent readers/writers touching different keys don't serialize
+// on a single lock. Each shard owns its own capacity and eviction
+// callback; there is no cross-shard capacity sharing.
+type ShardedLRUList[K comparable, V any] struct {
+	shards []*LRUList[K, V]
+	hash   func(K) uint64
+}
+
+// NewSharded creates a ShardedLRUList with the given number of shards,
+// each able to hold capPerShard entries. hash assigns keys to shards; if
+// nil, keys are hashed via fnv64 over fmt.Sprint(key), which works for
+// any comparable K but is slower and more collision-prone than a
+// type-specific hash.
+func NewSharded[K comparable, V any](shards, capPerShard int, evict func(K, V) error, hash func(K) uint64) *ShardedLRUList[K, V] {
+	if hash == nil {
+		hash = defaultShardHash[K]
+	}
+
+	ss := make([]*LRUList[K, V], shards)
+	for i := range ss {
+		ss[i] = New[K, V](capPerShard, evict)
+	}
+
+	return &ShardedLRUList[K, V]{shards: ss, hash: hash}
+}
+
+func defaultShardHash[K comparable](key K) uint64 {
+	h := fnv.New64a()
+	fmt.Fprint(h, key)
+	return h.Sum64()
+}
+
+func (sl *ShardedLRUList[K, V]) shardFor(key K) *LRUList[K, V] {
+	return sl.shards[sl.hash(key)%uint64(len(sl.shards))]
+}
+
+func (sl *ShardedLRUList[K, V]) Set(key K, val V) error {
+	return sl.shardFor(key).Set(key, val)
+}
+
+func (sl *ShardedLRUList[K, V]) Get(key K) (V, error) {
+	return sl.shardFor(key).Get(key)
+}
+
+// Traverse visits every shard in order, each under its own read lock, so
+// no single lock is held for the full cache at once.
+func (sl *ShardedLRUList[K, V]) Traverse(visit func(K, V) error) error {
+	for _, shard := range sl.shards {
+		if err := shard.Traverse(visit); err != nil {
+			return err
+		}
+	}
+	return nil
+}
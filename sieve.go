@@ -0,0 +1,161 @@
+package lrulist
+
+import (
+	"fmt"
+	"sync"
+)
+
+type sieveNode[K comparable, V any] struct {
+	key        K
+	val        V
+	visited    bool
+	prev, next *sieveNode[K, V]
+}
+
+// Sieve is an alternative to LRUList implementing the SIEVE eviction
+// policy: a single circular doubly-linked list plus a "hand" pointer that
+// sweeps it looking for an unvisited node to evict. Unlike LRUList, a Get
+// never splices the list, so hits are cheaper than a classic LRU.
+type Sieve[K comparable, V any] struct {
+	// Capacity limit of the list
+	cap int
+	// Mapping from cache keys to nodes
+	cacheMap map[K]*sieveNode[K, V]
+	// Head node of the list; newly inserted entries land here
+	cache *sieveNode[K, V]
+	// Eviction hand; walks the list looking for an unvisited node
+	hand *sieveNode[K, V]
+	// Callback function for eviction when exceeding capacity
+	evict func(K, V) error
+	// Read-write lock to protect concurrent access to the list
+	lock sync.RWMutex
+}
+
+func NewSieve[K comparable, V any](cap int, evict func(K, V) error) *Sieve[K, V] {
+	return &Sieve[K, V]{
+		cap:      cap,
+		cacheMap: make(map[K]*sieveNode[K, V]),
+		cache:    nil,
+		hand:     nil,
+		evict:    evict,
+		lock:     sync.RWMutex{},
+	}
+}
+
+func (s *Sieve[K, V]) Set(key K, val V) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if item, hit := s.cacheMap[key]; hit {
+		// If the key already exists, update its value and mark it visited
+		item.val = val
+		item.visited = true
+		return nil
+	}
+
+	if len(s.cacheMap) >= s.cap {
+		victim := s.evictOne()
+		if s.evict != nil {
+			if err := s.evict(victim.key, victim.val); err != nil {
+				return err
+			}
+		}
+		delete(s.cacheMap, victim.key)
+	}
+
+	item := &sieveNode[K, V]{key: key, val: val}
+	s.cacheMap[key] = item
+	s.insertHead(item)
+
+	return nil
+}
+
+func (s *Sieve[K, V]) Get(key K) (V, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	item, hit := s.cacheMap[key]
+	if !hit {
+		var zero V
+		return zero, fmt.Errorf("key doesn't hit")
+	}
+
+	// A hit only marks the node visited; it never moves in the list
+	item.visited = true
+	return item.val, nil
+}
+
+func (s *Sieve[K, V]) Traverse(visit func(K, V) error) error {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	if s.cache == nil {
+		return nil
+	}
+
+	err := visit(s.cache.key, s.cache.val)
+	if err != nil {
+		return err
+	}
+	for cur := s.cache.next; cur != s.cache; cur = cur.next {
+		err := visit(cur.key, cur.val)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// insertHead links item in as the new head of the circular list. The
+// caller must hold s.lock.
+func (s *Sieve[K, V]) insertHead(item *sieveNode[K, V]) {
+	if s.cache == nil {
+		item.prev, item.next = item, item
+	} else {
+		item.prev, item.next = s.cache.prev, s.cache
+		s.cache.prev.next, s.cache.prev = item, item
+	}
+	s.cache = item
+}
+
+// evictOne walks the hand backwards from the tail, clearing visited bits
+// until it finds an unvisited node, unlinks it and returns it. The caller
+// must hold s.lock and ensure the list is non-empty.
+func (s *Sieve[K, V]) evictOne() *sieveNode[K, V] {
+	if s.hand == nil {
+		s.hand = s.cache.prev
+	}
+
+	for {
+		node := s.hand
+		if !node.visited {
+			s.unlink(node)
+			return node
+		}
+		node.visited = false
+		s.hand = node.prev
+	}
+}
+
+// unlink removes item from the circular list, advancing the hand past it
+// if necessary. The caller must hold s.lock.
+func (s *Sieve[K, V]) unlink(item *sieveNode[K, V]) {
+	if s.hand == item {
+		if item.prev == item {
+			s.hand = nil
+		} else {
+			s.hand = item.prev
+		}
+	}
+
+	if item.next == item {
+		s.cache = nil
+		return
+	}
+
+	item.prev.next, item.next.prev = item.next, item.prev
+	if s.cache == item {
+		s.cache = item.next
+	}
+}
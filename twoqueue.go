@@ -0,0 +1,233 @@
+package lrulist
+
+import (
+	"fmt"
+	"sync"
+)
+
+// dqNode is the shared node type behind the three internal queues that
+// make up a TwoQueueList.
+type dqNode[K comparable, V any] struct {
+	key        K
+	val        V
+	prev, next *dqNode[K, V]
+}
+
+// dqueue is a circular doubly-linked list plus a key index, used both as
+// an LRU (via moveToFront on access) and as a plain FIFO (by never moving
+// existing nodes). head is the front of the queue; head.prev is the back.
+type dqueue[K comparable, V any] struct {
+	head *dqNode[K, V]
+	m    map[K]*dqNode[K, V]
+}
+
+func newDqueue[K comparable, V any]() *dqueue[K, V] {
+	return &dqueue[K, V]{m: make(map[K]*dqNode[K, V])}
+}
+
+func (q *dqueue[K, V]) len() int {
+	return len(q.m)
+}
+
+func (q *dqueue[K, V]) get(key K) (*dqNode[K, V], bool) {
+	node, hit := q.m[key]
+	return node, hit
+}
+
+func (q *dqueue[K, V]) pushFront(key K, val V) *dqNode[K, V] {
+	node := &dqNode[K, V]{key: key, val: val}
+	q.m[key] = node
+
+	if q.head == nil {
+		node.prev, node.next = node, node
+	} else {
+		node.prev, node.next = q.head.prev, q.head
+		q.head.prev.next, q.head.prev = node, node
+	}
+	q.head = node
+
+	return node
+}
+
+func (q *dqueue[K, V]) moveToFront(node *dqNode[K, V]) {
+	if node == q.head {
+		return
+	}
+	q.unlink(node)
+
+	node.prev, node.next = q.head.prev, q.head
+	q.head.prev.next, q.head.prev = node, node
+	q.head = node
+}
+
+// unlink removes node from the ring without touching the key index.
+func (q *dqueue[K, V]) unlink(node *dqNode[K, V]) {
+	if node.next == node {
+		q.head = nil
+		return
+	}
+	node.prev.next, node.next.prev = node.next, node.prev
+	if q.head == node {
+		q.head = node.next
+	}
+}
+
+func (q *dqueue[K, V]) remove(node *dqNode[K, V]) {
+	q.unlink(node)
+	delete(q.m, node.key)
+}
+
+// removeBack evicts and returns the least recently used / oldest node, or
+// nil if the queue is empty.
+func (q *dqueue[K, V]) removeBack() *dqNode[K, V] {
+	if q.head == nil {
+		return nil
+	}
+	back := q.head.prev
+	q.remove(back)
+	return back
+}
+
+// TwoQueueList is a scan-resistant cache implementing the 2Q algorithm: a
+// FIFO of recently-seen keys (A1in), an LRU of frequently-reused keys
+// (Am), and a ghost FIFO of recently-evicted keys (A1out) that carries no
+// values. A one-shot scan only ever displaces A1in, leaving Am untouched.
+type TwoQueueList[K comparable, V any] struct {
+	// Total capacity shared between A1in and Am
+	cap int
+	// Capacity of the recent FIFO queue A1in, default 25% of cap
+	a1inCap int
+	// Capacity of the ghost queue A1out, default 50% of cap
+	a1outCap int
+	a1in     *dqueue[K, V]
+	am       *dqueue[K, V]
+	a1out    *dqueue[K, struct{}]
+	// Callback function for eviction when an entry is permanently dropped
+	evict func(K, V) error
+	lock  sync.RWMutex
+}
+
+func NewTwoQueueList[K comparable, V any](cap int, evict func(K, V) error) *TwoQueueList[K, V] {
+	a1inCap := cap / 4
+	a1outCap := cap / 2
+
+	return &TwoQueueList[K, V]{
+		cap:      cap,
+		a1inCap:  a1inCap,
+		a1outCap: a1outCap,
+		a1in:     newDqueue[K, V](),
+		am:       newDqueue[K, V](),
+		a1out:    newDqueue[K, struct{}](),
+		evict:    evict,
+		lock:     sync.RWMutex{},
+	}
+}
+
+func (tql *TwoQueueList[K, V]) Set(key K, val V) error {
+	tql.lock.Lock()
+	defer tql.lock.Unlock()
+
+	if node, hit := tql.am.get(key); hit {
+		// Already frequent: promote and update in place
+		tql.am.moveToFront(node)
+		node.val = val
+		return nil
+	}
+
+	if node, hit := tql.a1in.get(key); hit {
+		// Still in the recent queue: leave its position alone
+		node.val = val
+		return nil
+	}
+
+	if node, hit := tql.a1out.get(key); hit {
+		// Seen before and evicted once already: promote straight into Am
+		tql.a1out.remove(node)
+		return tql.insertAm(key, val)
+	}
+
+	return tql.insertA1in(key, val)
+}
+
+func (tql *TwoQueueList[K, V]) Get(key K) (V, error) {
+	tql.lock.Lock()
+	defer tql.lock.Unlock()
+
+	if node, hit := tql.am.get(key); hit {
+		tql.am.moveToFront(node)
+		return node.val, nil
+	}
+
+	if node, hit := tql.a1in.get(key); hit {
+		return node.val, nil
+	}
+
+	var zero V
+	return zero, fmt.Errorf("key doesn't hit")
+}
+
+func (tql *TwoQueueList[K, V]) Traverse(visit func(K, V) error) error {
+	tql.lock.RLock()
+	defer tql.lock.RUnlock()
+
+	for _, q := range []*dqueue[K, V]{tql.am, tql.a1in} {
+		if q.head == nil {
+			continue
+		}
+		if err := visit(q.head.key, q.head.val); err != nil {
+			return err
+		}
+		for cur := q.head.next; cur != q.head; cur = cur.next {
+			if err := visit(cur.key, cur.val); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// insertAm inserts key/val into the frequent queue, evicting its oldest
+// entry if Am is at capacity. The caller must hold tql.lock.
+func (tql *TwoQueueList[K, V]) insertAm(key K, val V) error {
+	if tql.am.len() >= tql.cap-tql.a1inCap {
+		if victim := tql.am.removeBack(); victim != nil && tql.evict != nil {
+			if err := tql.evict(victim.key, victim.val); err != nil {
+				return err
+			}
+		}
+	}
+	tql.am.pushFront(key, val)
+	return nil
+}
+
+// insertA1in inserts key/val into the recent queue, pushing its oldest
+// entry's key (without value) into the ghost queue A1out if A1in is at
+// capacity. The caller must hold tql.lock.
+func (tql *TwoQueueList[K, V]) insertA1in(key K, val V) error {
+	if tql.a1in.len() >= tql.a1inCap {
+		if victim := tql.a1in.removeBack(); victim != nil {
+			if err := tql.ghost(victim.key); err != nil {
+				return err
+			}
+		}
+	}
+	tql.a1in.pushFront(key, val)
+	return nil
+}
+
+// ghost records key in the A1out ghost queue, evicting the oldest ghost
+// entry (and firing the eviction callback, since that key leaves the
+// cache entirely) if A1out is at capacity. The caller must hold tql.lock.
+func (tql *TwoQueueList[K, V]) ghost(key K) error {
+	if tql.a1out.len() >= tql.a1outCap {
+		if victim := tql.a1out.removeBack(); victim != nil && tql.evict != nil {
+			var zero V
+			if err := tql.evict(victim.key, zero); err != nil {
+				return err
+			}
+		}
+	}
+	tql.a1out.pushFront(key, struct{}{})
+	return nil
+}